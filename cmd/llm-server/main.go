@@ -0,0 +1,51 @@
+// Command llm-server exposes every provider this module supports under a single
+// OpenAI-compatible /v1/chat/completions and /v1/embeddings HTTP endpoint.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/joern1811/llm/pkg/server"
+)
+
+func main() {
+	addr := flag.String("addr", ":8080", "address to listen on")
+	systemPrompt := flag.String("system-prompt", "", "default system prompt used when a request sets none")
+	flag.Parse()
+
+	cfg := server.Config{
+		AnthropicAPIKey:     os.Getenv("ANTHROPIC_API_KEY"),
+		OpenAIAPIKey:        os.Getenv("OPENAI_API_KEY"),
+		GoogleAPIKey:        os.Getenv("GOOGLE_API_KEY"),
+		DefaultSystemPrompt: *systemPrompt,
+		ModelAliases:        parseAliases(os.Getenv("LLM_MODEL_ALIASES")),
+	}
+
+	srv := server.New(cfg)
+
+	fmt.Printf("llm-server listening on %s\n", *addr)
+	log.Fatal(http.ListenAndServe(*addr, srv))
+}
+
+// parseAliases reads a comma-separated "alias=provider:model" list, e.g.
+// "gpt-4o=openai:gpt-4o,local=ollama:llama3.1".
+func parseAliases(raw string) map[string]string {
+	aliases := map[string]string{}
+	if raw == "" {
+		return aliases
+	}
+
+	for _, pair := range strings.Split(raw, ",") {
+		name, target, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		aliases[name] = target
+	}
+	return aliases
+}
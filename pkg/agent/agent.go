@@ -0,0 +1,131 @@
+// Package agent turns an llm.Provider plus a tool registry into a full agent runtime:
+// it drives the "call tools, feed results back, repeat until final" loop that callers
+// would otherwise have to reimplement for every provider.
+package agent
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/joern1811/llm/pkg/llm"
+	"github.com/joern1811/llm/pkg/tools"
+)
+
+// Hooks lets callers (e.g. a TUI) observe an Agent's progress as it runs.
+type Hooks struct {
+	OnStep       func(step int)
+	OnToolCall   func(call llm.ToolCall)
+	OnToolResult func(call llm.ToolCall, result string, err error)
+}
+
+// RunResult is the outcome of a single Agent.Run call.
+type RunResult struct {
+	FinalMessage llm.Message
+	History      []llm.Message
+	Usage        llm.TokenUsage
+	Steps        int
+}
+
+// defaultMaxSteps bounds Agent.Run when no MaxSteps is configured.
+const defaultMaxSteps = 10
+
+// Agent drives the multi-turn tool-call loop for a single llm.Provider.
+type Agent struct {
+	Provider llm.Provider
+	Tools    *tools.Registry
+	MaxSteps int
+	Hooks    Hooks
+}
+
+// New creates an Agent. A maxSteps <= 0 defaults to defaultMaxSteps.
+func New(provider llm.Provider, registry *tools.Registry, maxSteps int) *Agent {
+	if maxSteps <= 0 {
+		maxSteps = defaultMaxSteps
+	}
+	return &Agent{Provider: provider, Tools: registry, MaxSteps: maxSteps}
+}
+
+// Run sends prompt to the provider and drives the tool-call loop: parse
+// msg.GetToolCalls(), dispatch each to the registry concurrently, feed the results
+// back via CreateToolResponse, and re-invoke until no tool calls are returned or
+// MaxSteps is reached.
+func (a *Agent) Run(ctx context.Context, prompt string) (RunResult, error) {
+	var history []llm.Message
+	var usage llm.TokenUsage
+
+	nextPrompt := prompt
+	for step := 0; step < a.MaxSteps; step++ {
+		if a.Hooks.OnStep != nil {
+			a.Hooks.OnStep(step)
+		}
+
+		msg, err := a.Provider.CreateMessage(ctx, nextPrompt, history, a.Tools.Specs(), llm.GenerationOptions{})
+		if err != nil {
+			return RunResult{History: history, Usage: usage, Steps: step}, fmt.Errorf("agent: step %d: %w", step, err)
+		}
+		usage = addUsage(usage, msg.GetUsage())
+		history = append(history, msg)
+		nextPrompt = ""
+
+		calls := msg.GetToolCalls()
+		if len(calls) == 0 {
+			return RunResult{FinalMessage: msg, History: history, Usage: usage, Steps: step + 1}, nil
+		}
+
+		responses, err := a.dispatch(ctx, calls)
+		if err != nil {
+			return RunResult{History: history, Usage: usage, Steps: step + 1}, err
+		}
+		history = append(history, responses...)
+	}
+
+	return RunResult{History: history, Usage: usage, Steps: a.MaxSteps}, fmt.Errorf("agent: exceeded max steps (%d)", a.MaxSteps)
+}
+
+// dispatch runs every tool call concurrently, reporting each through Hooks, and turns
+// the results into Messages via Provider.CreateToolResponse.
+func (a *Agent) dispatch(ctx context.Context, calls []llm.ToolCall) ([]llm.Message, error) {
+	responses := make([]llm.Message, len(calls))
+	errs := make([]error, len(calls))
+
+	var wg sync.WaitGroup
+	for i, call := range calls {
+		wg.Add(1)
+		go func(i int, call llm.ToolCall) {
+			defer wg.Done()
+
+			if a.Hooks.OnToolCall != nil {
+				a.Hooks.OnToolCall(call)
+			}
+
+			result, err := a.Tools.Dispatch(ctx, call.GetName(), call.GetArguments())
+			if a.Hooks.OnToolResult != nil {
+				a.Hooks.OnToolResult(call, result, err)
+			}
+			if err != nil {
+				result = fmt.Sprintf("error: %s", err)
+			}
+
+			resp, respErr := a.Provider.CreateToolResponse(call.GetID(), result)
+			responses[i] = resp
+			errs[i] = respErr
+		}(i, call)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return responses, nil
+}
+
+func addUsage(total, turn llm.TokenUsage) llm.TokenUsage {
+	return llm.TokenUsage{
+		PromptTokens:     total.PromptTokens + turn.PromptTokens,
+		CompletionTokens: total.CompletionTokens + turn.CompletionTokens,
+		TotalTokens:      total.TotalTokens + turn.TotalTokens,
+	}
+}
@@ -0,0 +1,147 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/joern1811/llm/pkg/llm"
+	"github.com/joern1811/llm/pkg/tools"
+)
+
+type fakeToolCall struct {
+	id, name string
+	args     map[string]interface{}
+}
+
+func (c fakeToolCall) GetID() string                        { return c.id }
+func (c fakeToolCall) GetName() string                      { return c.name }
+func (c fakeToolCall) GetArguments() map[string]interface{} { return c.args }
+
+type fakeMessage struct {
+	role      string
+	content   string
+	toolCalls []llm.ToolCall
+	usage     llm.TokenUsage
+}
+
+func (m fakeMessage) GetRole() string              { return m.role }
+func (m fakeMessage) GetContent() string           { return m.content }
+func (m fakeMessage) GetToolCalls() []llm.ToolCall { return m.toolCalls }
+func (m fakeMessage) IsToolResponse() bool         { return m.role == "tool" }
+func (m fakeMessage) GetUsage() llm.TokenUsage     { return m.usage }
+
+// fakeProvider replies with replies[i] on the i-th CreateMessage call, and wraps every
+// tool response as a "tool" message echoing the content it was given.
+type fakeProvider struct {
+	replies []llm.Message
+	calls   int
+}
+
+func (p *fakeProvider) CreateMessage(ctx context.Context, prompt string, messages []llm.Message, toolSpecs []llm.Tool, opts llm.GenerationOptions) (llm.Message, error) {
+	if p.calls >= len(p.replies) {
+		return nil, fmt.Errorf("fakeProvider: no reply queued for call %d", p.calls)
+	}
+	reply := p.replies[p.calls]
+	p.calls++
+	return reply, nil
+}
+
+func (p *fakeProvider) CreateToolResponse(toolCallID string, content interface{}) (llm.Message, error) {
+	return fakeMessage{role: "tool", content: fmt.Sprintf("%v", content)}, nil
+}
+
+func (p *fakeProvider) SupportsTools() bool { return true }
+func (p *fakeProvider) Name() string        { return "fake" }
+
+type fakeTool struct {
+	name string
+	fn   func(ctx context.Context, args map[string]any) (string, error)
+}
+
+func (t fakeTool) Spec() llm.Tool {
+	return llm.Tool{Name: t.name}
+}
+
+func (t fakeTool) Execute(ctx context.Context, args map[string]any) (string, error) {
+	return t.fn(ctx, args)
+}
+
+func TestAgentRunStopsWhenNoToolCalls(t *testing.T) {
+	provider := &fakeProvider{
+		replies: []llm.Message{fakeMessage{role: "assistant", content: "done"}},
+	}
+	a := New(provider, tools.NewRegistry(), 0)
+
+	result, err := a.Run(context.Background(), "hi")
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if result.Steps != 1 {
+		t.Fatalf("Steps = %d, want 1", result.Steps)
+	}
+	if result.FinalMessage.GetContent() != "done" {
+		t.Fatalf("FinalMessage.GetContent() = %q, want %q", result.FinalMessage.GetContent(), "done")
+	}
+	if len(result.History) != 1 {
+		t.Fatalf("len(History) = %d, want 1", len(result.History))
+	}
+}
+
+func TestAgentRunDispatchesToolCallsAndContinues(t *testing.T) {
+	registry := tools.NewRegistry()
+	registry.Register(fakeTool{
+		name: "echo",
+		fn: func(ctx context.Context, args map[string]any) (string, error) {
+			return fmt.Sprintf("%v", args["in"]), nil
+		},
+	})
+
+	provider := &fakeProvider{
+		replies: []llm.Message{
+			fakeMessage{
+				role: "assistant",
+				toolCalls: []llm.ToolCall{
+					fakeToolCall{id: "call-1", name: "echo", args: map[string]interface{}{"in": "a"}},
+					fakeToolCall{id: "call-2", name: "echo", args: map[string]interface{}{"in": "b"}},
+				},
+				usage: llm.TokenUsage{PromptTokens: 10, CompletionTokens: 5, TotalTokens: 15},
+			},
+			fakeMessage{role: "assistant", content: "done", usage: llm.TokenUsage{PromptTokens: 3, CompletionTokens: 2, TotalTokens: 5}},
+		},
+	}
+	a := New(provider, registry, 0)
+
+	result, err := a.Run(context.Background(), "hi")
+	if err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+	if result.Steps != 2 {
+		t.Fatalf("Steps = %d, want 2", result.Steps)
+	}
+	// assistant message + 2 tool responses + final assistant message
+	if len(result.History) != 4 {
+		t.Fatalf("len(History) = %d, want 4: %+v", len(result.History), result.History)
+	}
+	if result.Usage.TotalTokens != 20 {
+		t.Fatalf("Usage.TotalTokens = %d, want 20", result.Usage.TotalTokens)
+	}
+}
+
+func TestAgentRunReturnsErrorWhenMaxStepsExceeded(t *testing.T) {
+	call := fakeToolCall{id: "call-1", name: "echo", args: map[string]interface{}{}}
+	registry := tools.NewRegistry()
+	registry.Register(fakeTool{
+		name: "echo",
+		fn:   func(ctx context.Context, args map[string]any) (string, error) { return "ok", nil },
+	})
+
+	loopingReply := fakeMessage{role: "assistant", toolCalls: []llm.ToolCall{call}}
+	provider := &fakeProvider{replies: []llm.Message{loopingReply, loopingReply}}
+	a := New(provider, registry, 2)
+
+	_, err := a.Run(context.Background(), "hi")
+	if err == nil {
+		t.Fatal("Run succeeded, want an error for exceeding MaxSteps")
+	}
+}
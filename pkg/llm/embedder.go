@@ -0,0 +1,12 @@
+package llm
+
+import "context"
+
+// Embedder is implemented by providers that can turn text into vector embeddings,
+// as a sibling capability to Provider.
+type Embedder interface {
+	// Embed returns one embedding per input text, in the same order.
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+	// Dim returns the dimensionality of the vectors Embed produces.
+	Dim() int
+}
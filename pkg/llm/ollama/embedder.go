@@ -0,0 +1,55 @@
+package ollama
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/joern1811/llm/pkg/llm"
+	"github.com/ollama/ollama/api"
+)
+
+// Embedder implements llm.Embedder against Ollama's /api/embed endpoint.
+type Embedder struct {
+	client *api.Client
+	model  string
+	dim    int
+}
+
+// NewEmbedder creates an Embedder for model, probing it once to learn Dim() and to
+// fail fast if the model isn't pulled or doesn't support embeddings.
+func NewEmbedder(model string) (*Embedder, error) {
+	client, err := api.ClientFromEnvironment()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Embed(context.Background(), &api.EmbedRequest{
+		Model: model,
+		Input: "probe",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ollama: embedding model %q unavailable: %w", model, err)
+	}
+	if len(resp.Embeddings) == 0 {
+		return nil, fmt.Errorf("ollama: embedding model %q returned no embeddings", model)
+	}
+
+	return &Embedder{client: client, model: model, dim: len(resp.Embeddings[0])}, nil
+}
+
+func (e *Embedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	resp, err := e.client.Embed(ctx, &api.EmbedRequest{
+		Model: e.model,
+		Input: texts,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ollama: embed: %w", err)
+	}
+	return resp.Embeddings, nil
+}
+
+func (e *Embedder) Dim() int {
+	return e.dim
+}
+
+var _ llm.Embedder = (*Embedder)(nil)
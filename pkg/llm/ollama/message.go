@@ -0,0 +1,56 @@
+package ollama
+
+import (
+	"github.com/joern1811/llm/pkg/llm"
+	"github.com/ollama/ollama/api"
+)
+
+// OllamaMessage adapts an api.Message into llm.Message.
+type OllamaMessage struct {
+	Message    api.Message
+	ToolCallID string
+	Usage      llm.TokenUsage
+}
+
+func (m *OllamaMessage) GetRole() string {
+	return m.Message.Role
+}
+
+func (m *OllamaMessage) GetContent() string {
+	return m.Message.Content
+}
+
+func (m *OllamaMessage) IsToolResponse() bool {
+	return m.Message.Role == "tool"
+}
+
+func (m *OllamaMessage) GetToolCalls() []llm.ToolCall {
+	calls := make([]llm.ToolCall, 0, len(m.Message.ToolCalls))
+	for _, call := range m.Message.ToolCalls {
+		calls = append(calls, &OllamaToolCall{call: call})
+	}
+	return calls
+}
+
+// GetUsage reports the PromptEvalCount/EvalCount of the chat response that produced
+// this message, set by CreateMessage/CreateMessageStream once the final chunk arrives.
+func (m *OllamaMessage) GetUsage() llm.TokenUsage {
+	return m.Usage
+}
+
+// OllamaToolCall adapts an api.ToolCall into llm.ToolCall.
+type OllamaToolCall struct {
+	call api.ToolCall
+}
+
+func (t *OllamaToolCall) GetID() string {
+	return t.call.ID
+}
+
+func (t *OllamaToolCall) GetName() string {
+	return t.call.Function.Name
+}
+
+func (t *OllamaToolCall) GetArguments() map[string]interface{} {
+	return t.call.Function.Arguments.ToMap()
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/joern1811/llm/pkg/llm"
 	"github.com/ollama/ollama/api"
 	"github.com/ollama/ollama/types/model"
+	"strings"
 )
 
 func boolPtr(b bool) *bool {
@@ -16,30 +17,131 @@ func boolPtr(b bool) *bool {
 
 // Provider implements the Provider interface for Ollama
 type Provider struct {
-	client       *api.Client
-	model        string
-	systemPrompt string
+	client         *api.Client
+	model          string
+	systemPrompt   string
+	defaultOptions llm.GenerationOptions
 }
 
-// NewProvider creates a new Ollama provider
-func NewProvider(model string, systemPrompt string) (*Provider, error) {
+// defaultNumCtx matches the context window other Ollama integrations default to;
+// the server's own default is much smaller and quietly truncates history.
+const defaultNumCtx = 4096
+
+// NewProvider creates a new Ollama provider. It immediately verifies that the
+// Ollama server is reachable and that model has actually been pulled, returning a
+// descriptive error (listing the models that are available) otherwise so callers
+// don't hit a confusing 404 on the first CreateMessage call. opts supplies the
+// per-call defaults (e.g. temperature, num_ctx) used whenever a CreateMessage call
+// doesn't set them itself; if opts.NumCtx is nil it defaults to defaultNumCtx.
+func NewProvider(model string, systemPrompt string, opts llm.GenerationOptions) (*Provider, error) {
 	client, err := api.ClientFromEnvironment()
 	if err != nil {
 		return nil, err
 	}
-	return &Provider{
-		client:       client,
-		model:        model,
-		systemPrompt: systemPrompt,
-	}, nil
+
+	if opts.NumCtx == nil {
+		numCtx := defaultNumCtx
+		opts.NumCtx = &numCtx
+	}
+
+	p := &Provider{
+		client:         client,
+		model:          model,
+		systemPrompt:   systemPrompt,
+		defaultOptions: opts,
+	}
+
+	if err := p.checkModelAvailable(context.Background()); err != nil {
+		return nil, err
+	}
+
+	return p, nil
 }
 
-func (p *Provider) CreateMessage(
-	ctx context.Context,
+// checkModelAvailable confirms the server is reachable and p.model is pulled locally.
+func (p *Provider) checkModelAvailable(ctx context.Context) error {
+	resp, err := p.client.List(ctx)
+	if err != nil {
+		return fmt.Errorf("ollama server unreachable: %w", err)
+	}
+
+	available := make([]string, 0, len(resp.Models))
+	for _, m := range resp.Models {
+		available = append(available, m.Name)
+		if m.Name == p.model || m.Model == p.model || strings.TrimSuffix(m.Name, ":latest") == p.model {
+			return nil
+		}
+	}
+
+	return fmt.Errorf(
+		"model %q is not pulled on this Ollama server; available models: %s (try `ollama pull %s`)",
+		p.model, strings.Join(available, ", "), p.model,
+	)
+}
+
+// ModelInfo describes a model available on a connected Ollama server.
+type ModelInfo struct {
+	Name          string
+	Size          int64
+	Family        string
+	Quantization  string
+	SupportsTools bool
+}
+
+// ListModels returns the models currently pulled on this provider's Ollama server.
+func (p *Provider) ListModels(ctx context.Context) ([]ModelInfo, error) {
+	return listModels(ctx, p.client)
+}
+
+// ListAvailableModels connects to Ollama using the environment-configured host (see
+// api.ClientFromEnvironment) and lists the models pulled there, including whether each
+// one advertises tool-calling support.
+func ListAvailableModels(ctx context.Context) ([]ModelInfo, error) {
+	client, err := api.ClientFromEnvironment()
+	if err != nil {
+		return nil, err
+	}
+	return listModels(ctx, client)
+}
+
+func listModels(ctx context.Context, client *api.Client) ([]ModelInfo, error) {
+	resp, err := client.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	models := make([]ModelInfo, 0, len(resp.Models))
+	for _, m := range resp.Models {
+		info := ModelInfo{
+			Name:         m.Name,
+			Size:         m.Size,
+			Family:       m.Details.Family,
+			Quantization: m.Details.QuantizationLevel,
+		}
+
+		if show, err := client.Show(ctx, &api.ShowRequest{Model: m.Name}); err == nil {
+			for _, capability := range show.Capabilities {
+				if capability == model.CapabilityTools {
+					info.SupportsTools = true
+					break
+				}
+			}
+		}
+
+		models = append(models, info)
+	}
+
+	return models, nil
+}
+
+// buildChatRequest converts a prompt, history and tool set into an Ollama
+// ChatRequest, shared by CreateMessage and CreateMessageStream.
+func (p *Provider) buildChatRequest(
 	prompt string,
 	messages []llm.Message,
 	tools []llm.Tool,
-) (llm.Message, error) {
+	opts llm.GenerationOptions,
+) *api.ChatRequest {
 	// Convert generic messages to Ollama format
 	ollamaMessages := make([]api.Message, 0, len(messages)+1)
 
@@ -151,16 +253,90 @@ func (p *Provider) CreateMessage(
 		}
 	}
 
-	var response api.Message
+	merged := opts.Merge(p.defaultOptions)
 
-	err := p.client.Chat(ctx, &api.ChatRequest{
+	return &api.ChatRequest{
 		Model:    p.model,
 		Messages: ollamaMessages,
 		Tools:    ollamaTools,
 		Stream:   boolPtr(false),
-	}, func(r api.ChatResponse) error {
+		Options:  toOllamaOptions(merged),
+		Format:   toOllamaFormat(merged.ResponseFormat),
+	}
+}
+
+// toOllamaFormat translates a llm.ResponseFormat into Ollama's ChatRequest.Format:
+// the literal "json" for free-form JSON mode, or the raw schema for schema-constrained
+// JSON (supported by recent Ollama versions).
+func toOllamaFormat(format *llm.ResponseFormat) json.RawMessage {
+	if format == nil {
+		return nil
+	}
+
+	switch format.Type {
+	case "json_object":
+		return json.RawMessage(`"json"`)
+	case "json_schema":
+		schema, err := json.Marshal(format.Schema)
+		if err != nil {
+			return nil
+		}
+		return schema
+	default:
+		return nil
+	}
+}
+
+// toOllamaOptions serializes the subset of GenerationOptions Ollama understands into
+// the map[string]interface{} api.ChatRequest.Options expects.
+func toOllamaOptions(opts llm.GenerationOptions) map[string]interface{} {
+	options := map[string]interface{}{}
+
+	if opts.NumCtx != nil {
+		options["num_ctx"] = *opts.NumCtx
+	}
+	if opts.Temperature != nil {
+		options["temperature"] = *opts.Temperature
+	}
+	if opts.TopP != nil {
+		options["top_p"] = *opts.TopP
+	}
+	if opts.TopK != nil {
+		options["top_k"] = *opts.TopK
+	}
+	if len(opts.Stop) > 0 {
+		options["stop"] = opts.Stop
+	}
+	if opts.Seed != nil {
+		options["seed"] = *opts.Seed
+	}
+	if opts.MaxTokens != nil {
+		options["num_predict"] = *opts.MaxTokens
+	}
+
+	return options
+}
+
+func (p *Provider) CreateMessage(
+	ctx context.Context,
+	prompt string,
+	messages []llm.Message,
+	tools []llm.Tool,
+	opts llm.GenerationOptions,
+) (llm.Message, error) {
+	req := p.buildChatRequest(prompt, messages, tools, opts)
+
+	var response api.Message
+	var usage llm.TokenUsage
+
+	err := p.client.Chat(ctx, req, func(r api.ChatResponse) error {
 		if r.Done {
 			response = r.Message
+			usage = llm.TokenUsage{
+				PromptTokens:     r.PromptEvalCount,
+				CompletionTokens: r.EvalCount,
+				TotalTokens:      r.PromptEvalCount + r.EvalCount,
+			}
 		}
 		return nil
 	})
@@ -169,7 +345,81 @@ func (p *Provider) CreateMessage(
 		return nil, err
 	}
 
-	return &OllamaMessage{Message: response}, nil
+	return &OllamaMessage{Message: response, Usage: usage}, nil
+}
+
+// CreateMessageStream mirrors CreateMessage but emits incremental llm.StreamEvent
+// values as they arrive from Ollama's chat callback, which already delivers partial
+// api.ChatResponse values per chunk. Ollama delivers tool calls whole rather than
+// incrementally, so they are surfaced as a single Start/ArgsDelta/End triple once the
+// final chunk (r.Done) arrives.
+func (p *Provider) CreateMessageStream(
+	ctx context.Context,
+	prompt string,
+	messages []llm.Message,
+	tools []llm.Tool,
+	opts llm.GenerationOptions,
+) (<-chan llm.StreamEvent, error) {
+	req := p.buildChatRequest(prompt, messages, tools, opts)
+	req.Stream = boolPtr(true)
+
+	events := make(chan llm.StreamEvent, 16)
+
+	go func() {
+		defer close(events)
+
+		var (
+			content      strings.Builder
+			toolCalls    []api.ToolCall
+			usage        llm.TokenUsage
+			finishReason string
+		)
+
+		err := p.client.Chat(ctx, req, func(r api.ChatResponse) error {
+			if r.Message.Content != "" {
+				content.WriteString(r.Message.Content)
+				events <- llm.StreamEvent{Type: llm.TextDelta, Text: r.Message.Content}
+			}
+
+			if r.Done {
+				toolCalls = r.Message.ToolCalls
+				finishReason = r.DoneReason
+				usage = llm.TokenUsage{
+					PromptTokens:     r.PromptEvalCount,
+					CompletionTokens: r.EvalCount,
+					TotalTokens:      r.PromptEvalCount + r.EvalCount,
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			events <- llm.StreamEvent{Type: llm.MessageEnd, FinishReason: "error"}
+			return
+		}
+
+		for _, call := range toolCalls {
+			argsJSON, _ := json.Marshal(call.Function.Arguments.ToMap())
+			events <- llm.StreamEvent{Type: llm.ToolCallStart, ToolCallID: call.ID, ToolCallName: call.Function.Name}
+			events <- llm.StreamEvent{Type: llm.ToolCallArgsDelta, ToolCallID: call.ID, ToolCallName: call.Function.Name, ArgsDelta: string(argsJSON)}
+			events <- llm.StreamEvent{Type: llm.ToolCallEnd, ToolCallID: call.ID, ToolCallName: call.Function.Name}
+		}
+
+		events <- llm.StreamEvent{
+			Type: llm.MessageEnd,
+			Message: &OllamaMessage{
+				Message: api.Message{
+					Role:      "assistant",
+					Content:   content.String(),
+					ToolCalls: toolCalls,
+				},
+				Usage: usage,
+			},
+			Usage:        usage,
+			FinishReason: finishReason,
+		}
+	}()
+
+	return events, nil
 }
 
 func (p *Provider) SupportsTools() bool {
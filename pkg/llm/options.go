@@ -0,0 +1,57 @@
+package llm
+
+// GenerationOptions controls sampling and context behavior for a single
+// Provider.CreateMessage (or CreateMessageStream) call. Pointer fields are optional:
+// a nil field means "use the provider's default", which lets call sites override only
+// the knobs they care about.
+type GenerationOptions struct {
+	Temperature *float64
+	TopP        *float64
+	TopK        *int
+	MaxTokens   *int
+	Stop        []string
+	Seed        *int
+	NumCtx      *int
+
+	PresencePenalty  *float64
+	FrequencyPenalty *float64
+
+	// ResponseFormat constrains the shape of the assistant's reply; see response_format.go.
+	ResponseFormat *ResponseFormat
+}
+
+// Merge returns a copy of o with every unset (nil) field filled in from defaults.
+// Fields explicitly set on o always win.
+func (o GenerationOptions) Merge(defaults GenerationOptions) GenerationOptions {
+	if o.Temperature == nil {
+		o.Temperature = defaults.Temperature
+	}
+	if o.TopP == nil {
+		o.TopP = defaults.TopP
+	}
+	if o.TopK == nil {
+		o.TopK = defaults.TopK
+	}
+	if o.MaxTokens == nil {
+		o.MaxTokens = defaults.MaxTokens
+	}
+	if o.Stop == nil {
+		o.Stop = defaults.Stop
+	}
+	if o.Seed == nil {
+		o.Seed = defaults.Seed
+	}
+	if o.NumCtx == nil {
+		o.NumCtx = defaults.NumCtx
+	}
+	if o.PresencePenalty == nil {
+		o.PresencePenalty = defaults.PresencePenalty
+	}
+	if o.FrequencyPenalty == nil {
+		o.FrequencyPenalty = defaults.FrequencyPenalty
+	}
+	if o.ResponseFormat == nil {
+		o.ResponseFormat = defaults.ResponseFormat
+	}
+	return o
+}
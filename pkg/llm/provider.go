@@ -0,0 +1,55 @@
+// Package llm defines the provider-agnostic types shared by every backend
+// (Anthropic, OpenAI, Google, Ollama) under pkg/llm/*.
+package llm
+
+import "context"
+
+// Provider is the common interface implemented by every backend.
+type Provider interface {
+	// CreateMessage sends prompt plus the prior messages/tools to the model and
+	// returns the complete assistant turn. opts may be the zero value to use the
+	// provider's defaults for every sampling/context knob.
+	CreateMessage(ctx context.Context, prompt string, messages []Message, tools []Tool, opts GenerationOptions) (Message, error)
+
+	// CreateToolResponse wraps a tool's result as a Message suitable for being
+	// appended to the conversation history and fed back via CreateMessage.
+	CreateToolResponse(toolCallID string, content interface{}) (Message, error)
+
+	// SupportsTools reports whether the configured model can be offered tools.
+	SupportsTools() bool
+
+	// Name returns the provider's short identifier, e.g. "ollama".
+	Name() string
+}
+
+// Message is a single turn in a conversation, produced by either the user or a provider.
+type Message interface {
+	GetRole() string
+	GetContent() string
+	GetToolCalls() []ToolCall
+	IsToolResponse() bool
+	// GetUsage reports the token accounting for the turn that produced this message,
+	// populated from the provider's response (zero value for user-authored messages).
+	GetUsage() TokenUsage
+}
+
+// ToolCall represents a single function invocation requested by the model.
+type ToolCall interface {
+	GetID() string
+	GetName() string
+	GetArguments() map[string]interface{}
+}
+
+// Tool describes a function the model may call.
+type Tool struct {
+	Name        string
+	Description string
+	InputSchema ToolInputSchema
+}
+
+// ToolInputSchema is the JSON-schema description of a Tool's arguments.
+type ToolInputSchema struct {
+	Type       string
+	Required   []string
+	Properties map[string]interface{}
+}
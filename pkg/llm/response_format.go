@@ -0,0 +1,48 @@
+package llm
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// ResponseFormat constrains the shape of a provider's reply. See GenerationOptions.
+//
+// Only ollama implements it so far: ChatRequest.Format is set to "json" for Type
+// "json_object" and to the raw Schema JSON for Type "json_schema" (see
+// ollama.Provider.buildChatRequest). anthropic, openai and google are not implemented
+// yet; when they are, the expected approach is openai/google's native
+// response_format/responseSchema, and for anthropic (which has no native equivalent)
+// injecting a single synthetic tool whose input schema is Schema, forcing its use, and
+// treating that tool call's arguments as the response.
+type ResponseFormat struct {
+	// Type is "json_object" for free-form JSON, or "json_schema" for Schema-constrained JSON.
+	Type string
+	// Schema is the JSON schema the reply must conform to; only used when Type is "json_schema".
+	Schema interface{}
+}
+
+// Unmarshal extracts msg's assistant content and decodes it into v. If the provider
+// used the tool-coercion workaround (content is empty but a tool call is present), the
+// first tool call's arguments are decoded instead.
+func Unmarshal[T any](msg Message, v *T) error {
+	content := msg.GetContent()
+
+	if content == "" {
+		if calls := msg.GetToolCalls(); len(calls) > 0 {
+			argsJSON, err := json.Marshal(calls[0].GetArguments())
+			if err != nil {
+				return fmt.Errorf("llm: marshaling tool-call arguments: %w", err)
+			}
+			content = string(argsJSON)
+		}
+	}
+
+	if content == "" {
+		return fmt.Errorf("llm: message has no content to unmarshal")
+	}
+
+	if err := json.Unmarshal([]byte(content), v); err != nil {
+		return fmt.Errorf("llm: unmarshaling response into %T: %w", v, err)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package llm
+
+import "context"
+
+// StreamEventType identifies the kind of incremental update carried by a StreamEvent.
+type StreamEventType string
+
+const (
+	// TextDelta carries an incremental chunk of assistant text.
+	TextDelta StreamEventType = "text_delta"
+	// ToolCallStart announces a new tool call; ToolCallID/ToolCallName are set.
+	ToolCallStart StreamEventType = "tool_call_start"
+	// ToolCallArgsDelta carries an incremental chunk of a tool call's JSON arguments.
+	ToolCallArgsDelta StreamEventType = "tool_call_args_delta"
+	// ToolCallEnd closes out a tool call started by ToolCallStart.
+	ToolCallEnd StreamEventType = "tool_call_end"
+	// MessageEnd is the terminal event for a CreateMessageStream call.
+	MessageEnd StreamEventType = "message_end"
+)
+
+// StreamEvent is one incremental update emitted by Provider.CreateMessageStream.
+type StreamEvent struct {
+	Type StreamEventType
+
+	// Text carries the delta for TextDelta events.
+	Text string
+
+	// ToolCallID and ToolCallName identify the call a ToolCall* event belongs to.
+	ToolCallID   string
+	ToolCallName string
+	// ArgsDelta carries the partial JSON-encoded arguments for ToolCallArgsDelta events.
+	ArgsDelta string
+
+	// Message, Usage and FinishReason are only populated on the MessageEnd event.
+	Message      Message
+	Usage        TokenUsage
+	FinishReason string
+}
+
+// TokenUsage reports token accounting for a single provider turn.
+type TokenUsage struct {
+	PromptTokens     int
+	CompletionTokens int
+	TotalTokens      int
+}
+
+// StreamingProvider is implemented by providers with a native streaming API.
+// Providers without one can satisfy it via FallbackCreateMessageStream.
+type StreamingProvider interface {
+	Provider
+	CreateMessageStream(ctx context.Context, prompt string, messages []Message, tools []Tool, opts GenerationOptions) (<-chan StreamEvent, error)
+}
+
+// FallbackCreateMessageStream adapts a provider's non-streaming CreateMessage into the
+// streaming interface by emitting a single terminal MessageEnd event. Providers without a
+// native streaming API implement CreateMessageStream in terms of this helper.
+func FallbackCreateMessageStream(ctx context.Context, create func(ctx context.Context) (Message, error)) (<-chan StreamEvent, error) {
+	msg, err := create(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan StreamEvent, 1)
+	events <- StreamEvent{Type: MessageEnd, Message: msg}
+	close(events)
+	return events, nil
+}
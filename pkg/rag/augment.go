@@ -0,0 +1,49 @@
+package rag
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/template"
+)
+
+// DefaultTemplate renders the retrieved Results above the user's question. It's the
+// template AugmentPrompt uses when called with an empty tmpl string.
+const DefaultTemplate = `Use the following retrieved context to answer the question. If the context doesn't contain the answer, say so explicitly.
+{{range .Results}}
+---
+{{.Document.Text}}
+{{end}}
+Question: {{.Prompt}}`
+
+// augmentData is the template data AugmentPrompt exposes as {{.Prompt}} / {{.Results}}.
+type augmentData struct {
+	Prompt  string
+	Results []Result
+}
+
+// AugmentPrompt queries collection for the k documents most relevant to userPrompt and
+// renders them, together with userPrompt, into tmpl (a text/template string; pass ""
+// to use DefaultTemplate). The result is ready to hand to llm.Provider.CreateMessage.
+func AugmentPrompt(ctx context.Context, collection *Collection, userPrompt string, k int, tmpl string) (string, error) {
+	if tmpl == "" {
+		tmpl = DefaultTemplate
+	}
+
+	results, err := collection.Query(ctx, userPrompt, k)
+	if err != nil {
+		return "", err
+	}
+
+	t, err := template.New("rag").Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("rag: parsing template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, augmentData{Prompt: userPrompt, Results: results}); err != nil {
+		return "", fmt.Errorf("rag: rendering template: %w", err)
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,53 @@
+package rag
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/joern1811/llm/pkg/llm"
+)
+
+// Collection ties a VectorStore to the llm.Embedder used to populate and query it.
+type Collection struct {
+	store    VectorStore
+	embedder llm.Embedder
+}
+
+// NewCollection creates a Collection over store, embedding new documents and queries
+// with embedder.
+func NewCollection(store VectorStore, embedder llm.Embedder) *Collection {
+	return &Collection{store: store, embedder: embedder}
+}
+
+// Add embeds any docs missing an Embedding and adds them all to the store.
+func (c *Collection) Add(ctx context.Context, docs []Document) error {
+	pending := make([]int, 0, len(docs))
+	texts := make([]string, 0, len(docs))
+	for i, doc := range docs {
+		if doc.Embedding == nil {
+			pending = append(pending, i)
+			texts = append(texts, doc.Text)
+		}
+	}
+
+	if len(texts) > 0 {
+		embeddings, err := c.embedder.Embed(ctx, texts)
+		if err != nil {
+			return fmt.Errorf("rag: embedding documents: %w", err)
+		}
+		for i, idx := range pending {
+			docs[idx].Embedding = embeddings[i]
+		}
+	}
+
+	return c.store.Add(docs)
+}
+
+// Query embeds text and returns the k most similar Documents in the store.
+func (c *Collection) Query(ctx context.Context, text string, k int) ([]Result, error) {
+	embeddings, err := c.embedder.Embed(ctx, []string{text})
+	if err != nil {
+		return nil, fmt.Errorf("rag: embedding query: %w", err)
+	}
+	return c.store.Query(embeddings[0], k)
+}
@@ -0,0 +1,66 @@
+package rag
+
+import (
+	"context"
+	"testing"
+)
+
+// stubEmbedder returns a fixed-length embedding per input text, recording every text
+// it was asked to embed so tests can assert which documents actually hit Embed.
+type stubEmbedder struct {
+	calls [][]string
+}
+
+func (e *stubEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	e.calls = append(e.calls, texts)
+	out := make([][]float32, len(texts))
+	for i := range texts {
+		out[i] = []float32{1, 2, 3}
+	}
+	return out, nil
+}
+
+func (e *stubEmbedder) Dim() int { return 3 }
+
+func TestCollectionAddEmbedsOnlyDocsMissingEmbedding(t *testing.T) {
+	embedder := &stubEmbedder{}
+	store := NewMemoryStore()
+	collection := NewCollection(store, embedder)
+
+	docs := []Document{
+		{ID: "has-embedding", Embedding: []float32{9, 9, 9}},
+		{ID: "needs-embedding", Text: "embed me"},
+	}
+
+	if err := collection.Add(context.Background(), docs); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	if len(embedder.calls) != 1 || len(embedder.calls[0]) != 1 || embedder.calls[0][0] != "embed me" {
+		t.Fatalf("Embed calls = %+v, want a single call for [\"embed me\"]", embedder.calls)
+	}
+
+	results, err := store.Query([]float32{1, 2, 3}, 2)
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("len(results) = %d, want 2", len(results))
+	}
+
+	var gotEmbedded bool
+	for _, r := range results {
+		if r.Document.ID == "has-embedding" && r.Document.Embedding[0] != 9 {
+			t.Fatalf("Add overwrote an existing embedding: %+v", r.Document)
+		}
+		if r.Document.ID == "needs-embedding" {
+			gotEmbedded = true
+			if len(r.Document.Embedding) != 3 {
+				t.Fatalf("needs-embedding was not embedded: %+v", r.Document)
+			}
+		}
+	}
+	if !gotEmbedded {
+		t.Fatalf("needs-embedding not found in store after Add")
+	}
+}
@@ -0,0 +1,104 @@
+package rag
+
+import (
+	"encoding/gob"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"sync"
+)
+
+// MemoryStore is a brute-force, in-process VectorStore backed by cosine similarity.
+// It's suitable for up to a few thousand documents; beyond that a dedicated vector
+// database should be used instead. It persists to disk as gob-encoded Documents.
+type MemoryStore struct {
+	mu   sync.RWMutex
+	docs []Document
+}
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+func (s *MemoryStore) Add(docs []Document) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.docs = append(s.docs, docs...)
+	return nil
+}
+
+func (s *MemoryStore) Query(embedding []float32, k int) ([]Result, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	results := make([]Result, 0, len(s.docs))
+	for _, doc := range s.docs {
+		results = append(results, Result{Document: doc, Score: cosineSimilarity(embedding, doc.Embedding)})
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i].Score > results[j].Score })
+
+	if k < 0 {
+		k = 0
+	}
+	if k < len(results) {
+		results = results[:k]
+	}
+	return results, nil
+}
+
+// Save gob-encodes every Document to path.
+func (s *MemoryStore) Save(path string) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("rag: saving store: %w", err)
+	}
+	defer f.Close()
+
+	if err := gob.NewEncoder(f).Encode(s.docs); err != nil {
+		return fmt.Errorf("rag: encoding store: %w", err)
+	}
+	return nil
+}
+
+// Load replaces the store's contents with the gob-encoded Documents at path.
+func (s *MemoryStore) Load(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("rag: loading store: %w", err)
+	}
+	defer f.Close()
+
+	var docs []Document
+	if err := gob.NewDecoder(f).Decode(&docs); err != nil {
+		return fmt.Errorf("rag: decoding store: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.docs = docs
+	return nil
+}
+
+func cosineSimilarity(a, b []float32) float32 {
+	if len(a) == 0 || len(a) != len(b) {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+
+	return float32(dot / (math.Sqrt(normA) * math.Sqrt(normB)))
+}
@@ -0,0 +1,79 @@
+package rag
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestMemoryStoreQueryRanksBySimilarity(t *testing.T) {
+	store := NewMemoryStore()
+	docs := []Document{
+		{ID: "close", Embedding: []float32{1, 0}},
+		{ID: "orthogonal", Embedding: []float32{0, 1}},
+		{ID: "opposite", Embedding: []float32{-1, 0}},
+	}
+	if err := store.Add(docs); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	results, err := store.Query([]float32{1, 0}, 2)
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("len(results) = %d, want 2", len(results))
+	}
+	if results[0].Document.ID != "close" {
+		t.Fatalf("results[0].Document.ID = %q, want %q", results[0].Document.ID, "close")
+	}
+	if results[0].Score < results[1].Score {
+		t.Fatalf("results not sorted by descending score: %+v", results)
+	}
+}
+
+func TestMemoryStoreQueryClampsNegativeK(t *testing.T) {
+	store := NewMemoryStore()
+	if err := store.Add([]Document{{ID: "a", Embedding: []float32{1}}}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	results, err := store.Query([]float32{1}, -1)
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if len(results) != 0 {
+		t.Fatalf("len(results) = %d, want 0", len(results))
+	}
+}
+
+func TestMemoryStoreSaveLoadRoundTrip(t *testing.T) {
+	store := NewMemoryStore()
+	docs := []Document{
+		{ID: "a", Text: "hello", Metadata: map[string]string{"k": "v"}, Embedding: []float32{1, 2, 3}},
+		{ID: "b", Text: "world", Embedding: []float32{4, 5, 6}},
+	}
+	if err := store.Add(docs); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "store.gob")
+	if err := store.Save(path); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	loaded := NewMemoryStore()
+	if err := loaded.Load(path); err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	results, err := loaded.Query([]float32{1, 2, 3}, 2)
+	if err != nil {
+		t.Fatalf("Query: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("len(results) = %d, want 2", len(results))
+	}
+	if results[0].Document.ID != "a" || results[0].Document.Text != "hello" || results[0].Document.Metadata["k"] != "v" {
+		t.Fatalf("Load did not round-trip document: %+v", results[0].Document)
+	}
+}
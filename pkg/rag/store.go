@@ -0,0 +1,28 @@
+// Package rag provides retrieval-augmented generation building blocks: a pluggable
+// VectorStore, a Collection that ties a store to an llm.Embedder, and a helper to
+// render retrieved context into a prompt before handing it to llm.Provider.CreateMessage.
+package rag
+
+// Document is a single piece of retrievable content.
+type Document struct {
+	ID        string
+	Text      string
+	Metadata  map[string]string
+	Embedding []float32
+}
+
+// Result is a Document returned from a VectorStore query, along with its similarity
+// score (higher is more similar).
+type Result struct {
+	Document Document
+	Score    float32
+}
+
+// VectorStore persists Documents and serves nearest-neighbor queries over their
+// embeddings. MemoryStore is the default, in-process implementation.
+type VectorStore interface {
+	Add(docs []Document) error
+	Query(embedding []float32, k int) ([]Result, error)
+	Save(path string) error
+	Load(path string) error
+}
@@ -0,0 +1,43 @@
+package server
+
+// Config configures a Server.
+type Config struct {
+	AnthropicAPIKey string
+	OpenAIAPIKey    string
+	GoogleAPIKey    string
+
+	// DefaultSystemPrompt is used whenever a request carries no "system" message.
+	DefaultSystemPrompt string
+
+	// ModelAliases maps a request's "model" field to a "provider:model" string, e.g.
+	// {"gpt-4o": "openai:gpt-4o", "local-llama": "ollama:llama3.1"}. A model name with
+	// no alias is passed through to utils.CreateProvider as-is.
+	ModelAliases map[string]string
+}
+
+// resolveModel applies ModelAliases, falling back to model unchanged.
+func (c Config) resolveModel(model string) string {
+	if alias, ok := c.ModelAliases[model]; ok {
+		return alias
+	}
+	return model
+}
+
+// apiKeyFor returns the configured API key for the provider prefix of modelString, if
+// any; utils.CreateProvider falls back to the provider's environment variable when empty.
+func (c Config) apiKeyFor(modelString string) string {
+	switch {
+	case hasPrefix(modelString, "anthropic:"):
+		return c.AnthropicAPIKey
+	case hasPrefix(modelString, "openai:"):
+		return c.OpenAIAPIKey
+	case hasPrefix(modelString, "google:"):
+		return c.GoogleAPIKey
+	default:
+		return ""
+	}
+}
+
+func hasPrefix(s, prefix string) bool {
+	return len(s) >= len(prefix) && s[:len(prefix)] == prefix
+}
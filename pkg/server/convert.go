@@ -0,0 +1,131 @@
+package server
+
+import (
+	"encoding/json"
+
+	"github.com/joern1811/llm/pkg/llm"
+)
+
+// requestMessage adapts a ChatMessage into llm.Message so incoming OpenAI-shaped
+// history can be passed straight to Provider.CreateMessage.
+type requestMessage struct {
+	msg ChatMessage
+}
+
+func (m requestMessage) GetRole() string    { return m.msg.Role }
+func (m requestMessage) GetContent() string { return m.msg.Content }
+func (m requestMessage) IsToolResponse() bool {
+	return m.msg.Role == "tool"
+}
+func (m requestMessage) GetUsage() llm.TokenUsage { return llm.TokenUsage{} }
+
+func (m requestMessage) GetToolCalls() []llm.ToolCall {
+	calls := make([]llm.ToolCall, 0, len(m.msg.ToolCalls))
+	for _, c := range m.msg.ToolCalls {
+		calls = append(calls, requestToolCall{call: c})
+	}
+	return calls
+}
+
+type requestToolCall struct {
+	call ChatToolCall
+}
+
+func (t requestToolCall) GetID() string   { return t.call.ID }
+func (t requestToolCall) GetName() string { return t.call.Function.Name }
+func (t requestToolCall) GetArguments() map[string]interface{} {
+	var args map[string]interface{}
+	_ = json.Unmarshal([]byte(t.call.Function.Arguments), &args)
+	return args
+}
+
+// toLLMMessages converts an OpenAI-shaped chat history into []llm.Message. Splitting
+// off the final message as the prompt and any "system" message as the system prompt
+// is handled by the caller - this only performs the message-shape conversion.
+func toLLMMessages(messages []ChatMessage) []llm.Message {
+	converted := make([]llm.Message, 0, len(messages))
+	for _, m := range messages {
+		converted = append(converted, requestMessage{msg: m})
+	}
+	return converted
+}
+
+// applyToolChoice narrows tools according to an OpenAI-shaped tool_choice value:
+// the string "none" strips every tool, so the model isn't offered any; a
+// {"type":"function","function":{"name":...}} object narrows the list down to that
+// single tool, which is the closest equivalent llm.Provider.CreateMessage offers to
+// forcing a specific call, since it has no native tool_choice parameter. "auto" and
+// any other/missing value leave tools untouched.
+func applyToolChoice(tools []llm.Tool, toolChoice interface{}) []llm.Tool {
+	switch choice := toolChoice.(type) {
+	case string:
+		if choice == "none" {
+			return nil
+		}
+		return tools
+	case map[string]interface{}:
+		function, _ := choice["function"].(map[string]interface{})
+		name, _ := function["name"].(string)
+		for _, t := range tools {
+			if t.Name == name {
+				return []llm.Tool{t}
+			}
+		}
+		return tools
+	default:
+		return tools
+	}
+}
+
+// toLLMTools converts OpenAI-shaped tool definitions into []llm.Tool.
+func toLLMTools(tools []ChatTool) []llm.Tool {
+	converted := make([]llm.Tool, 0, len(tools))
+	for _, t := range tools {
+		schema := llm.ToolInputSchema{Type: "object"}
+		if props, ok := t.Function.Parameters["properties"].(map[string]interface{}); ok {
+			schema.Properties = props
+		}
+		// json.Unmarshal decodes JSON arrays into []interface{}, never []string, so
+		// extract each element individually rather than type-asserting the slice itself.
+		if required, ok := t.Function.Parameters["required"].([]interface{}); ok {
+			for _, r := range required {
+				if name, ok := r.(string); ok {
+					schema.Required = append(schema.Required, name)
+				}
+			}
+		}
+		converted = append(converted, llm.Tool{
+			Name:        t.Function.Name,
+			Description: t.Function.Description,
+			InputSchema: schema,
+		})
+	}
+	return converted
+}
+
+// fromLLMMessage converts a provider's reply into an OpenAI-shaped ChatMessage.
+func fromLLMMessage(msg llm.Message) ChatMessage {
+	out := ChatMessage{Role: "assistant", Content: msg.GetContent()}
+
+	for _, call := range msg.GetToolCalls() {
+		argsJSON, _ := json.Marshal(call.GetArguments())
+		out.ToolCalls = append(out.ToolCalls, ChatToolCall{
+			ID:   call.GetID(),
+			Type: "function",
+			Function: ChatFunctionCall{
+				Name:      call.GetName(),
+				Arguments: string(argsJSON),
+			},
+		})
+	}
+
+	return out
+}
+
+func fromLLMUsage(u llm.TokenUsage) ChatUsage {
+	return ChatUsage{
+		PromptTokens:     u.PromptTokens,
+		CompletionTokens: u.CompletionTokens,
+		TotalTokens:      u.TotalTokens,
+	}
+}
@@ -0,0 +1,235 @@
+// Package server exposes any llm.Provider under the OpenAI /v1/chat/completions and
+// /v1/embeddings HTTP surface, so existing OpenAI-client libraries (LangChain,
+// LlamaIndex, ...) can point at Anthropic, Google or Ollama transparently.
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/joern1811/llm/pkg/llm"
+	"github.com/joern1811/llm/pkg/utils"
+)
+
+// Server implements http.Handler for the OpenAI-compatible surface.
+type Server struct {
+	cfg Config
+	mux *http.ServeMux
+}
+
+// New creates a Server configured by cfg.
+func New(cfg Config) *Server {
+	s := &Server{cfg: cfg, mux: http.NewServeMux()}
+	s.mux.HandleFunc("/v1/chat/completions", s.handleChatCompletions)
+	s.mux.HandleFunc("/v1/embeddings", s.handleEmbeddings)
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) handleChatCompletions(w http.ResponseWriter, r *http.Request) {
+	var req ChatCompletionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	systemPrompt, history, prompt := splitMessages(req.Messages, s.cfg.DefaultSystemPrompt)
+	modelString := s.cfg.resolveModel(req.Model)
+
+	provider, err := utils.CreateProvider(r.Context(), modelString, "", s.cfg.apiKeyFor(modelString), systemPrompt, llm.GenerationOptions{})
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	tools := applyToolChoice(toLLMTools(req.Tools), req.ToolChoice)
+
+	if req.Stream {
+		s.streamChatCompletion(w, r.Context(), provider, req.Model, prompt, history, tools)
+		return
+	}
+
+	msg, err := provider.CreateMessage(r.Context(), prompt, history, tools, llm.GenerationOptions{})
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+
+	finishReason := "stop"
+	if len(msg.GetToolCalls()) > 0 {
+		finishReason = "tool_calls"
+	}
+
+	responseMessage := fromLLMMessage(msg)
+	resp := ChatCompletionResponse{
+		ID:     "chatcmpl-1",
+		Object: "chat.completion",
+		Model:  req.Model,
+		Choices: []ChatChoice{{
+			Index:        0,
+			Message:      &responseMessage,
+			FinishReason: finishReason,
+		}},
+		Usage: fromLLMUsage(msg.GetUsage()),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+func (s *Server) streamChatCompletion(
+	w http.ResponseWriter,
+	ctx context.Context,
+	provider llm.Provider,
+	model, prompt string,
+	history []llm.Message,
+	tools []llm.Tool,
+) {
+	streaming, ok := provider.(llm.StreamingProvider)
+	if !ok {
+		writeError(w, http.StatusNotImplemented, fmt.Errorf("provider %s does not support streaming", provider.Name()))
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, fmt.Errorf("streaming unsupported by response writer"))
+		return
+	}
+
+	events, err := streaming.CreateMessageStream(ctx, prompt, history, tools, llm.GenerationOptions{})
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	writeChunk := func(choice ChatChoice) {
+		chunk := ChatCompletionChunk{
+			ID:      "chatcmpl-1",
+			Object:  "chat.completion.chunk",
+			Model:   model,
+			Choices: []ChatChoice{choice},
+		}
+		data, _ := json.Marshal(chunk)
+		fmt.Fprintf(w, "data: %s\n\n", data)
+		flusher.Flush()
+	}
+
+	for event := range events {
+		switch event.Type {
+		case llm.TextDelta:
+			writeChunk(ChatChoice{Delta: &ChatMessage{Content: event.Text}})
+		case llm.ToolCallStart:
+			writeChunk(ChatChoice{Delta: &ChatMessage{ToolCalls: []ChatToolCall{{
+				ID:   event.ToolCallID,
+				Type: "function",
+				Function: ChatFunctionCall{
+					Name: event.ToolCallName,
+				},
+			}}}})
+		case llm.ToolCallArgsDelta:
+			writeChunk(ChatChoice{Delta: &ChatMessage{ToolCalls: []ChatToolCall{{
+				ID: event.ToolCallID,
+				Function: ChatFunctionCall{
+					Arguments: event.ArgsDelta,
+				},
+			}}}})
+		case llm.MessageEnd:
+			finishReason := event.FinishReason
+			if finishReason == "" {
+				finishReason = "stop"
+			}
+			writeChunk(ChatChoice{FinishReason: finishReason})
+		}
+	}
+
+	fmt.Fprint(w, "data: [DONE]\n\n")
+	flusher.Flush()
+}
+
+func (s *Server) handleEmbeddings(w http.ResponseWriter, r *http.Request) {
+	var req EmbeddingsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var texts []string
+	switch input := req.Input.(type) {
+	case string:
+		texts = []string{input}
+	case []interface{}:
+		for _, v := range input {
+			if s, ok := v.(string); ok {
+				texts = append(texts, s)
+			}
+		}
+	default:
+		writeError(w, http.StatusBadRequest, fmt.Errorf("unsupported input type for embeddings"))
+		return
+	}
+
+	embedder, err := utils.CreateEmbedder(s.cfg.resolveModel(req.Model))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	embeddings, err := embedder.Embed(r.Context(), texts)
+	if err != nil {
+		writeError(w, http.StatusBadGateway, err)
+		return
+	}
+
+	data := make([]EmbeddingData, len(embeddings))
+	for i, e := range embeddings {
+		data[i] = EmbeddingData{Object: "embedding", Index: i, Embedding: e}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(EmbeddingsResponse{
+		Object: "list",
+		Model:  req.Model,
+		Data:   data,
+	})
+}
+
+// splitMessages pulls the "system" message (if any) out of messages, treats the final
+// message as the new prompt, and returns everything before it as history - matching
+// the (prompt, messages) shape every llm.Provider.CreateMessage expects.
+func splitMessages(messages []ChatMessage, defaultSystemPrompt string) (systemPrompt string, history []llm.Message, prompt string) {
+	systemPrompt = defaultSystemPrompt
+
+	var filtered []ChatMessage
+	for _, m := range messages {
+		if m.Role == "system" {
+			systemPrompt = m.Content
+			continue
+		}
+		filtered = append(filtered, m)
+	}
+
+	if len(filtered) == 0 {
+		return systemPrompt, nil, ""
+	}
+
+	last := filtered[len(filtered)-1]
+	return systemPrompt, toLLMMessages(filtered[:len(filtered)-1]), last.Content
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": map[string]string{"message": err.Error()},
+	})
+}
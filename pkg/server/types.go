@@ -0,0 +1,104 @@
+package server
+
+// This file mirrors the subset of the OpenAI /v1/chat/completions and /v1/embeddings
+// request/response schema the server supports, so any OpenAI-client library can point
+// at this module unmodified.
+
+// ChatCompletionRequest is the body of a POST /v1/chat/completions request.
+type ChatCompletionRequest struct {
+	Model      string        `json:"model"`
+	Messages   []ChatMessage `json:"messages"`
+	Stream     bool          `json:"stream"`
+	Tools      []ChatTool    `json:"tools,omitempty"`
+	ToolChoice interface{}   `json:"tool_choice,omitempty"`
+}
+
+// ChatMessage is one OpenAI-shaped message, covering user/assistant/tool roles.
+type ChatMessage struct {
+	Role       string         `json:"role"`
+	Content    string         `json:"content"`
+	ToolCalls  []ChatToolCall `json:"tool_calls,omitempty"`
+	ToolCallID string         `json:"tool_call_id,omitempty"`
+}
+
+// ChatTool is an OpenAI-shaped tool definition.
+type ChatTool struct {
+	Type     string       `json:"type"`
+	Function ChatFunction `json:"function"`
+}
+
+// ChatFunction describes a callable function within a ChatTool.
+type ChatFunction struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Parameters  map[string]interface{} `json:"parameters,omitempty"`
+}
+
+// ChatToolCall is an OpenAI-shaped tool invocation emitted by the assistant.
+type ChatToolCall struct {
+	ID       string           `json:"id"`
+	Type     string           `json:"type"`
+	Function ChatFunctionCall `json:"function"`
+}
+
+// ChatFunctionCall carries a tool call's name and JSON-encoded arguments.
+type ChatFunctionCall struct {
+	Name      string `json:"name"`
+	Arguments string `json:"arguments"`
+}
+
+// ChatCompletionResponse is the body of a non-streaming /v1/chat/completions reply.
+type ChatCompletionResponse struct {
+	ID      string       `json:"id"`
+	Object  string       `json:"object"`
+	Model   string       `json:"model"`
+	Choices []ChatChoice `json:"choices"`
+	Usage   ChatUsage    `json:"usage"`
+}
+
+// ChatChoice wraps a single completion candidate; this server always returns exactly one.
+type ChatChoice struct {
+	Index int `json:"index"`
+	// Message is set on non-streaming completions, Delta on streaming chunks - never
+	// both. They're pointers so the unused one is actually omitted: encoding/json
+	// never omits a zero-value struct, only a nil pointer.
+	Message      *ChatMessage `json:"message,omitempty"`
+	Delta        *ChatMessage `json:"delta,omitempty"`
+	FinishReason string       `json:"finish_reason,omitempty"`
+}
+
+// ChatUsage reports token accounting, mirroring OpenAI's usage object.
+type ChatUsage struct {
+	PromptTokens     int `json:"prompt_tokens"`
+	CompletionTokens int `json:"completion_tokens"`
+	TotalTokens      int `json:"total_tokens"`
+}
+
+// ChatCompletionChunk is one SSE chunk of a streaming /v1/chat/completions reply.
+type ChatCompletionChunk struct {
+	ID      string       `json:"id"`
+	Object  string       `json:"object"`
+	Model   string       `json:"model"`
+	Choices []ChatChoice `json:"choices"`
+}
+
+// EmbeddingsRequest is the body of a POST /v1/embeddings request.
+type EmbeddingsRequest struct {
+	Model string      `json:"model"`
+	Input interface{} `json:"input"`
+}
+
+// EmbeddingsResponse is the body of a /v1/embeddings reply.
+type EmbeddingsResponse struct {
+	Object string          `json:"object"`
+	Model  string          `json:"model"`
+	Data   []EmbeddingData `json:"data"`
+	Usage  ChatUsage       `json:"usage"`
+}
+
+// EmbeddingData is a single embedding result within an EmbeddingsResponse.
+type EmbeddingData struct {
+	Object    string    `json:"object"`
+	Index     int       `json:"index"`
+	Embedding []float32 `json:"embedding"`
+}
@@ -0,0 +1,270 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/joern1811/llm/pkg/llm"
+)
+
+func stringProp(description string) map[string]interface{} {
+	return map[string]interface{}{"type": "string", "description": description}
+}
+
+func intProp(description string) map[string]interface{} {
+	return map[string]interface{}{"type": "integer", "description": description}
+}
+
+// ReadFileTool reads a file's contents within a Sandbox.
+type ReadFileTool struct{ Sandbox *Sandbox }
+
+func (t *ReadFileTool) Spec() llm.Tool {
+	return llm.Tool{
+		Name:        "read_file",
+		Description: "Read the contents of a file at a path relative to the sandbox root.",
+		InputSchema: llm.ToolInputSchema{
+			Type:     "object",
+			Required: []string{"path"},
+			Properties: map[string]interface{}{
+				"path": stringProp("Path to the file, relative to the sandbox root."),
+			},
+		},
+	}
+}
+
+func (t *ReadFileTool) Execute(_ context.Context, args map[string]any) (string, error) {
+	path, _ := args["path"].(string)
+	resolved, err := t.Sandbox.Resolve(path)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := os.ReadFile(resolved)
+	if err != nil {
+		return "", fmt.Errorf("read_file: %w", err)
+	}
+	return string(content), nil
+}
+
+// WriteFileTool writes (overwriting) a file's contents within a Sandbox.
+type WriteFileTool struct{ Sandbox *Sandbox }
+
+func (t *WriteFileTool) Spec() llm.Tool {
+	return llm.Tool{
+		Name:        "write_file",
+		Description: "Write content to a file at a path relative to the sandbox root, creating or overwriting it.",
+		InputSchema: llm.ToolInputSchema{
+			Type:     "object",
+			Required: []string{"path", "content"},
+			Properties: map[string]interface{}{
+				"path":    stringProp("Path to the file, relative to the sandbox root."),
+				"content": stringProp("Content to write."),
+			},
+		},
+	}
+}
+
+func (t *WriteFileTool) Execute(_ context.Context, args map[string]any) (string, error) {
+	path, _ := args["path"].(string)
+	content, _ := args["content"].(string)
+
+	resolved, err := t.Sandbox.Resolve(path)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(resolved), 0o755); err != nil {
+		return "", fmt.Errorf("write_file: %w", err)
+	}
+	if err := os.WriteFile(resolved, []byte(content), 0o644); err != nil {
+		return "", fmt.Errorf("write_file: %w", err)
+	}
+	return fmt.Sprintf("wrote %d bytes to %s", len(content), path), nil
+}
+
+// EditFileTool performs a single, literal search/replace within a file in a Sandbox.
+type EditFileTool struct{ Sandbox *Sandbox }
+
+func (t *EditFileTool) Spec() llm.Tool {
+	return llm.Tool{
+		Name:        "edit_file",
+		Description: "Replace old_text with new_text in a file, relative to the sandbox root. Fails unless old_text occurs exactly once.",
+		InputSchema: llm.ToolInputSchema{
+			Type:     "object",
+			Required: []string{"path", "old_text", "new_text"},
+			Properties: map[string]interface{}{
+				"path":     stringProp("Path to the file, relative to the sandbox root."),
+				"old_text": stringProp("Exact text to find; must match exactly once."),
+				"new_text": stringProp("Text to replace it with."),
+			},
+		},
+	}
+}
+
+func (t *EditFileTool) Execute(_ context.Context, args map[string]any) (string, error) {
+	path, _ := args["path"].(string)
+	oldText, _ := args["old_text"].(string)
+	newText, _ := args["new_text"].(string)
+
+	resolved, err := t.Sandbox.Resolve(path)
+	if err != nil {
+		return "", err
+	}
+
+	content, err := os.ReadFile(resolved)
+	if err != nil {
+		return "", fmt.Errorf("edit_file: %w", err)
+	}
+
+	count := strings.Count(string(content), oldText)
+	if count != 1 {
+		return "", fmt.Errorf("edit_file: old_text must match exactly once, found %d matches", count)
+	}
+
+	updated := strings.Replace(string(content), oldText, newText, 1)
+	if err := os.WriteFile(resolved, []byte(updated), 0o644); err != nil {
+		return "", fmt.Errorf("edit_file: %w", err)
+	}
+	return fmt.Sprintf("edited %s", path), nil
+}
+
+// ListDirTool lists the immediate entries of a directory within a Sandbox.
+type ListDirTool struct{ Sandbox *Sandbox }
+
+func (t *ListDirTool) Spec() llm.Tool {
+	return llm.Tool{
+		Name:        "list_dir",
+		Description: "List the immediate files and subdirectories of a directory, relative to the sandbox root.",
+		InputSchema: llm.ToolInputSchema{
+			Type:     "object",
+			Required: []string{"path"},
+			Properties: map[string]interface{}{
+				"path": stringProp("Path to the directory, relative to the sandbox root."),
+			},
+		},
+	}
+}
+
+func (t *ListDirTool) Execute(_ context.Context, args map[string]any) (string, error) {
+	path, _ := args["path"].(string)
+	resolved, err := t.Sandbox.Resolve(path)
+	if err != nil {
+		return "", err
+	}
+
+	entries, err := os.ReadDir(resolved)
+	if err != nil {
+		return "", fmt.Errorf("list_dir: %w", err)
+	}
+
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if e.IsDir() {
+			names = append(names, e.Name()+"/")
+		} else {
+			names = append(names, e.Name())
+		}
+	}
+	return strings.Join(names, "\n"), nil
+}
+
+// dirNode is one entry of a DirTreeTool result.
+type dirNode struct {
+	Name     string    `json:"name"`
+	Type     string    `json:"type"`
+	Children []dirNode `json:"children,omitempty"`
+}
+
+const maxDirTreeDepth = 5
+
+// DirTreeTool returns a depth-limited, nested JSON description of a directory tree.
+type DirTreeTool struct{ Sandbox *Sandbox }
+
+func (t *DirTreeTool) Spec() llm.Tool {
+	return llm.Tool{
+		Name:        "dir_tree",
+		Description: "Recursively describe a directory as nested {name, type, children} JSON, relative to the sandbox root.",
+		InputSchema: llm.ToolInputSchema{
+			Type:     "object",
+			Required: []string{"path"},
+			Properties: map[string]interface{}{
+				"path":      stringProp("Path to the directory, relative to the sandbox root."),
+				"max_depth": intProp("Maximum recursion depth (default 0, meaning unlimited up to the 5-level cap)."),
+			},
+		},
+	}
+}
+
+func (t *DirTreeTool) Execute(_ context.Context, args map[string]any) (string, error) {
+	path, _ := args["path"].(string)
+	depth := maxDirTreeDepth
+	if raw, ok := args["max_depth"]; ok {
+		if d, ok := toInt(raw); ok && d > 0 && d < maxDirTreeDepth {
+			depth = d
+		}
+	}
+
+	resolved, err := t.Sandbox.Resolve(path)
+	if err != nil {
+		return "", err
+	}
+
+	root := filepath.Base(resolved)
+	node, err := buildDirNode(resolved, root, depth)
+	if err != nil {
+		return "", fmt.Errorf("dir_tree: %w", err)
+	}
+
+	out, err := json.Marshal(node)
+	if err != nil {
+		return "", fmt.Errorf("dir_tree: %w", err)
+	}
+	return string(out), nil
+}
+
+func buildDirNode(path, name string, depthRemaining int) (dirNode, error) {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return dirNode{}, err
+	}
+	if !info.IsDir() {
+		return dirNode{Name: name, Type: "file"}, nil
+	}
+
+	node := dirNode{Name: name, Type: "dir"}
+	if depthRemaining <= 0 {
+		return node, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return dirNode{}, err
+	}
+
+	for _, e := range entries {
+		child, err := buildDirNode(filepath.Join(path, e.Name()), e.Name(), depthRemaining-1)
+		if err != nil {
+			return dirNode{}, err
+		}
+		node.Children = append(node.Children, child)
+	}
+
+	return node, nil
+}
+
+func toInt(v any) (int, bool) {
+	switch n := v.(type) {
+	case int:
+		return n, true
+	case int64:
+		return int(n), true
+	case float64:
+		return int(n), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,58 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/joern1811/llm/pkg/llm"
+)
+
+const maxHTTPFetchBytes = 1 << 20 // 1MiB, enough for typical API/HTML responses without risking huge model inputs
+
+// HTTPFetchTool performs a GET request and returns the response body as text.
+type HTTPFetchTool struct {
+	Client *http.Client
+}
+
+func (t *HTTPFetchTool) Spec() llm.Tool {
+	return llm.Tool{
+		Name:        "http_fetch",
+		Description: "Fetch a URL via HTTP GET and return up to 1MiB of the response body as text.",
+		InputSchema: llm.ToolInputSchema{
+			Type:     "object",
+			Required: []string{"url"},
+			Properties: map[string]interface{}{
+				"url": stringProp("The URL to fetch."),
+			},
+		},
+	}
+}
+
+func (t *HTTPFetchTool) Execute(ctx context.Context, args map[string]any) (string, error) {
+	url, _ := args["url"].(string)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", fmt.Errorf("http_fetch: %w", err)
+	}
+
+	client := t.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("http_fetch: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxHTTPFetchBytes))
+	if err != nil {
+		return "", fmt.Errorf("http_fetch: %w", err)
+	}
+
+	return fmt.Sprintf("HTTP %d\n%s", resp.StatusCode, body), nil
+}
@@ -0,0 +1,85 @@
+package tools
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Sandbox confines filesystem tools to a root directory, rejecting any path that
+// escapes it via ".." segments or symlink traversal.
+type Sandbox struct {
+	root string
+}
+
+// NewSandbox creates a Sandbox rooted at root, which must already exist.
+func NewSandbox(root string) (*Sandbox, error) {
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("tools: resolving sandbox root: %w", err)
+	}
+
+	resolved, err := filepath.EvalSymlinks(abs)
+	if err != nil {
+		return nil, fmt.Errorf("tools: sandbox root %q: %w", root, err)
+	}
+
+	return &Sandbox{root: resolved}, nil
+}
+
+// Resolve joins path onto the sandbox root and verifies the result - after resolving
+// any symlinks along the way - stays within the root. It returns an absolute path
+// safe to pass to os.* calls.
+func (s *Sandbox) Resolve(path string) (string, error) {
+	joined := filepath.Join(s.root, path)
+	if !withinRoot(joined, s.root) {
+		return "", fmt.Errorf("tools: path %q escapes sandbox root", path)
+	}
+
+	// The target need not exist yet (e.g. write_file creating a new file), so walk up
+	// to the nearest existing ancestor before checking for symlink escapes.
+	resolved, err := resolveExistingAncestor(joined)
+	if err != nil {
+		return "", err
+	}
+	if !withinRoot(resolved, s.root) {
+		return "", fmt.Errorf("tools: path %q escapes sandbox root via symlink", path)
+	}
+
+	return joined, nil
+}
+
+// withinRoot reports whether path is root itself or a descendant of it. A plain
+// strings.HasPrefix(path, root) is not enough: it would also accept a sibling
+// directory whose name merely starts with root's name (e.g. root "/data/sandbox"
+// would wrongly admit "/data/sandbox-evil").
+func withinRoot(path, root string) bool {
+	return path == root || strings.HasPrefix(path, root+string(filepath.Separator))
+}
+
+// Root returns the sandbox's resolved absolute root directory.
+func (s *Sandbox) Root() string {
+	return s.root
+}
+
+func resolveExistingAncestor(path string) (string, error) {
+	for p := path; ; p = filepath.Dir(p) {
+		resolved, err := filepath.EvalSymlinks(p)
+		switch {
+		case err == nil:
+			rest, relErr := filepath.Rel(p, path)
+			if relErr != nil {
+				return "", relErr
+			}
+			return filepath.Join(resolved, rest), nil
+		case os.IsNotExist(err):
+			if filepath.Dir(p) == p {
+				return "", fmt.Errorf("tools: no existing ancestor for %q", path)
+			}
+			continue
+		default:
+			return "", err
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package tools
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSandboxResolveRejectsSiblingWithSharedPrefix(t *testing.T) {
+	base := t.TempDir()
+	root := filepath.Join(base, "sandbox")
+	evil := filepath.Join(base, "sandbox-evil")
+
+	for _, dir := range []string{root, evil} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatalf("MkdirAll(%q): %v", dir, err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(evil, "secret.txt"), []byte("secret"), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	sandbox, err := NewSandbox(root)
+	if err != nil {
+		t.Fatalf("NewSandbox: %v", err)
+	}
+
+	if _, err := sandbox.Resolve("../sandbox-evil/secret.txt"); err == nil {
+		t.Fatal("Resolve(\"../sandbox-evil/secret.txt\") succeeded, want an escape error")
+	}
+}
+
+func TestSandboxResolveAllowsPathsWithinRoot(t *testing.T) {
+	root := t.TempDir()
+	sandbox, err := NewSandbox(root)
+	if err != nil {
+		t.Fatalf("NewSandbox: %v", err)
+	}
+
+	resolved, err := sandbox.Resolve("sub/file.txt")
+	if err != nil {
+		t.Fatalf("Resolve: %v", err)
+	}
+	if want := filepath.Join(root, "sub/file.txt"); resolved != want {
+		t.Fatalf("Resolve = %q, want %q", resolved, want)
+	}
+}
@@ -0,0 +1,62 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/joern1811/llm/pkg/llm"
+)
+
+// RunShellTool executes an allow-listed command in a configured working directory.
+type RunShellTool struct {
+	// AllowedCommands is the set of binaries (argv[0]) that may be run.
+	AllowedCommands []string
+	// WorkDir is the directory commands run in.
+	WorkDir string
+}
+
+func (t *RunShellTool) Spec() llm.Tool {
+	return llm.Tool{
+		Name:        "run_shell",
+		Description: fmt.Sprintf("Run an allow-listed shell command (one of: %s) in the configured working directory.", strings.Join(t.AllowedCommands, ", ")),
+		InputSchema: llm.ToolInputSchema{
+			Type:     "object",
+			Required: []string{"command"},
+			Properties: map[string]interface{}{
+				"command": stringProp("The command to run, including arguments (e.g. \"ls -la\")."),
+			},
+		},
+	}
+}
+
+func (t *RunShellTool) Execute(ctx context.Context, args map[string]any) (string, error) {
+	command, _ := args["command"].(string)
+	fields := strings.Fields(command)
+	if len(fields) == 0 {
+		return "", fmt.Errorf("run_shell: empty command")
+	}
+
+	if !t.isAllowed(fields[0]) {
+		return "", fmt.Errorf("run_shell: command %q is not in the allow-list", fields[0])
+	}
+
+	cmd := exec.CommandContext(ctx, fields[0], fields[1:]...)
+	cmd.Dir = t.WorkDir
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("run_shell: %w: %s", err, out)
+	}
+	return string(out), nil
+}
+
+func (t *RunShellTool) isAllowed(name string) bool {
+	for _, allowed := range t.AllowedCommands {
+		if allowed == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+// Package tools provides ready-to-use llm.Tool implementations (filesystem, shell,
+// HTTP) plus a Registry that wires them into Provider.CreateMessage calls and
+// dispatches the resulting tool calls back through Provider.CreateToolResponse.
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/joern1811/llm/pkg/llm"
+)
+
+// Tool is a concrete, executable implementation of an llm.Tool.
+type Tool interface {
+	// Spec returns the llm.Tool description sent to the model.
+	Spec() llm.Tool
+	// Execute runs the tool against the model-supplied arguments and returns the
+	// result to feed back via Provider.CreateToolResponse.
+	Execute(ctx context.Context, args map[string]any) (string, error)
+}
+
+// Registry holds the set of tools offered to a provider for a single agent/session.
+type Registry struct {
+	tools map[string]Tool
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{tools: make(map[string]Tool)}
+}
+
+// Register adds t to the registry, keyed by its spec name. Registering a tool under
+// a name that's already taken replaces the previous one.
+func (r *Registry) Register(t Tool) {
+	r.tools[t.Spec().Name] = t
+}
+
+// Specs returns the []llm.Tool to pass to Provider.CreateMessage.
+func (r *Registry) Specs() []llm.Tool {
+	specs := make([]llm.Tool, 0, len(r.tools))
+	for _, t := range r.tools {
+		specs = append(specs, t.Spec())
+	}
+	return specs
+}
+
+// Dispatch runs the named tool with args, returning an error if no tool is registered
+// under that name.
+func (r *Registry) Dispatch(ctx context.Context, name string, args map[string]any) (string, error) {
+	t, ok := r.tools[name]
+	if !ok {
+		return "", fmt.Errorf("tools: no tool registered with name %q", name)
+	}
+	return t.Execute(ctx, args)
+}
+
+// HandleToolCalls dispatches every tool call on msg and turns each result into a
+// Message via provider.CreateToolResponse, ready to append to conversation history.
+func (r *Registry) HandleToolCalls(ctx context.Context, provider llm.Provider, msg llm.Message) ([]llm.Message, error) {
+	calls := msg.GetToolCalls()
+	responses := make([]llm.Message, 0, len(calls))
+
+	for _, call := range calls {
+		result, err := r.Dispatch(ctx, call.GetName(), call.GetArguments())
+		if err != nil {
+			result = fmt.Sprintf("error: %s", err)
+		}
+
+		resp, err := provider.CreateToolResponse(call.GetID(), result)
+		if err != nil {
+			return responses, err
+		}
+		responses = append(responses, resp)
+	}
+
+	return responses, nil
+}
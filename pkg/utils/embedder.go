@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/joern1811/llm/pkg/llm"
+	"github.com/joern1811/llm/pkg/llm/ollama"
+)
+
+// CreateEmbedder builds the llm.Embedder named by modelString, using the same
+// "provider:model" convention as CreateProvider (e.g. "ollama:nomic-embed-text").
+func CreateEmbedder(modelString string) (llm.Embedder, error) {
+	parts := strings.SplitN(modelString, ":", 2)
+	if len(parts) < 2 {
+		return nil, fmt.Errorf(
+			"invalid model format. Expected provider:model, got %s",
+			modelString,
+		)
+	}
+
+	provider := parts[0]
+	model := parts[1]
+
+	switch provider {
+	case "ollama":
+		return ollama.NewEmbedder(model)
+
+	default:
+		return nil, fmt.Errorf("unsupported embedding provider: %s", provider)
+	}
+}
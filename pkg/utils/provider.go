@@ -9,11 +9,23 @@ import (
 	"github.com/joern1811/llm/pkg/llm/ollama"
 	"github.com/joern1811/llm/pkg/llm/openai"
 	"os"
+	"strconv"
 	"strings"
 )
 
-// Add new function to create provider
-func CreateProvider(ctx context.Context, modelString, baseURL, apiKey, systemPrompt string) (llm.Provider, error) {
+// CreateProvider builds the llm.Provider named by modelString ("provider:model", e.g.
+// "ollama:llama3.1"). opts supplies the generation defaults (temperature, num_ctx, ...)
+// the provider applies when a CreateMessage call doesn't override them itself;
+// any knob left unset in opts falls back to the matching LLM_* environment variable
+// (see generationOptionsFromEnv). Only the ollama provider receives these defaults;
+// anthropic, openai and google providers are constructed without them. Only ollama currently implements llm.StreamingProvider
+// (via its native chat-callback streaming); anthropic, openai and google are not
+// implemented yet. A provider without a native streaming implementation can satisfy
+// the interface via llm.FallbackCreateMessageStream, which emits a single terminal
+// event.
+func CreateProvider(ctx context.Context, modelString, baseURL, apiKey, systemPrompt string, opts llm.GenerationOptions) (llm.Provider, error) {
+	opts = opts.Merge(generationOptionsFromEnv())
+
 	parts := strings.SplitN(modelString, ":", 2)
 	if len(parts) < 2 {
 		return nil, fmt.Errorf(
@@ -41,7 +53,10 @@ func CreateProvider(ctx context.Context, modelString, baseURL, apiKey, systemPro
 		return anthropic.NewProvider(apiKey, baseURL, model, systemPrompt), nil
 
 	case "ollama":
-		return ollama.NewProvider(model, systemPrompt)
+		if model == "*" {
+			return createOllamaAutoProvider(ctx, systemPrompt, opts)
+		}
+		return ollama.NewProvider(model, systemPrompt, opts)
 
 	case "openai":
 		if apiKey == "" {
@@ -71,3 +86,71 @@ func CreateProvider(ctx context.Context, modelString, baseURL, apiKey, systemPro
 		return nil, fmt.Errorf("unsupported provider: %s", provider)
 	}
 }
+
+// createOllamaAutoProvider implements the special "ollama:*" model string: it
+// discovers the models pulled on the local Ollama server and picks the first
+// tool-capable one, printing the selection so the choice isn't silent.
+func createOllamaAutoProvider(ctx context.Context, systemPrompt string, opts llm.GenerationOptions) (llm.Provider, error) {
+	models, err := ollama.ListAvailableModels(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("ollama model discovery failed: %w", err)
+	}
+
+	for _, m := range models {
+		if m.SupportsTools {
+			fmt.Printf("ollama: auto-selected tool-capable model %q\n", m.Name)
+			return ollama.NewProvider(m.Name, systemPrompt, opts)
+		}
+	}
+
+	return nil, fmt.Errorf("ollama: no tool-capable model is pulled locally; pull one with `ollama pull <model>`")
+}
+
+// generationOptionsFromEnv reads generation defaults from LLM_TEMPERATURE, LLM_TOP_P,
+// LLM_TOP_K, LLM_NUM_CTX, LLM_SEED, LLM_MAX_TOKENS and LLM_STOP (comma-separated), so
+// deployments can pin defaults without threading CLI flags through every call site.
+func generationOptionsFromEnv() llm.GenerationOptions {
+	var opts llm.GenerationOptions
+
+	if v, ok := envFloat("LLM_TEMPERATURE"); ok {
+		opts.Temperature = &v
+	}
+	if v, ok := envFloat("LLM_TOP_P"); ok {
+		opts.TopP = &v
+	}
+	if v, ok := envInt("LLM_TOP_K"); ok {
+		opts.TopK = &v
+	}
+	if v, ok := envInt("LLM_NUM_CTX"); ok {
+		opts.NumCtx = &v
+	}
+	if v, ok := envInt("LLM_SEED"); ok {
+		opts.Seed = &v
+	}
+	if v, ok := envInt("LLM_MAX_TOKENS"); ok {
+		opts.MaxTokens = &v
+	}
+	if v := os.Getenv("LLM_STOP"); v != "" {
+		opts.Stop = strings.Split(v, ",")
+	}
+
+	return opts
+}
+
+func envFloat(key string) (float64, bool) {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return 0, false
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	return f, err == nil
+}
+
+func envInt(key string) (int, bool) {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return 0, false
+	}
+	i, err := strconv.Atoi(v)
+	return i, err == nil
+}